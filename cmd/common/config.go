@@ -0,0 +1,47 @@
+// Copyright 2015 The dpar Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common
+
+import "github.com/spf13/viper"
+
+// Config is the root configuration for the dpar binary. It is
+// unmarshaled from whatever combination of config file, environment
+// variables and flags Viper was set up with.
+type Config struct {
+	Parser ParserConfig `mapstructure:"parser"`
+}
+
+// ParserConfig holds the settings shared by the parse, train and
+// evaluate subcommands.
+type ParserConfig struct {
+	// Model is the path of the trained model.
+	Model string `mapstructure:"model"`
+
+	// Features is the path of the feature template file.
+	Features string `mapstructure:"features"`
+
+	// Transitions is the path of the transitions file.
+	Transitions string `mapstructure:"transitions"`
+
+	// HashKernelSize is the size of the feature hashing kernel. A
+	// size of zero disables feature hashing.
+	HashKernelSize uint `mapstructure:"hashKernelSize"`
+
+	// System is the name of the transition system to use.
+	System string `mapstructure:"system"`
+}
+
+// ConfigFromViper unmarshals a Config from v. Since v is typically
+// configured with a config file, environment variables, and bound
+// flags (in that order of increasing precedence), this picks up
+// whichever form the caller last set for a given key.
+func ConfigFromViper(v *viper.Viper) (*Config, error) {
+	var config Config
+	if err := v.Unmarshal(&config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2015 The dpar Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/danieldk/dpar/system"
+)
+
+// TransitionSyntaxError is returned when a transitions file cannot be
+// parsed. It is the sibling of symbolic.FeatureSyntaxError: it points
+// at the line that could not be parsed rather than leaving the
+// caller to guess.
+type TransitionSyntaxError struct {
+	// Line is the 1-based line number at which parsing failed.
+	Line uint
+
+	// Context is the (trimmed) text of the offending line.
+	Context string
+
+	// Msg describes what went wrong.
+	Msg string
+
+	// InnerErr is the underlying error, if any, returned by the
+	// transition system while parsing the line.
+	InnerErr error
+}
+
+func (e *TransitionSyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at line %d: %s\n\t%d\t%s", e.Line, e.Msg, e.Line, e.Context)
+}
+
+// Unwrap returns the error that caused this syntax error, allowing
+// callers to use errors.As/errors.Is to inspect it.
+func (e *TransitionSyntaxError) Unwrap() error {
+	return e.InnerErr
+}
+
+// ReadTransitions reads a transitions file, which lists one
+// transition per line (e.g. "left_arc(nsubj)"), and registers the
+// dependency relations used in those transitions with transitionSystem,
+// returning the resulting LabelNumberer.
+func ReadTransitions(path string, transitionSystem system.TransitionSystem) (*system.LabelNumberer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	labelNumberer := system.NewLabelNumberer()
+
+	reader := bufio.NewReader(f)
+	var eof = false
+	var lineNum uint
+
+	for !eof {
+		line, err := reader.ReadString('\n')
+		lineNum++
+
+		if err != nil {
+			if err == io.EOF {
+				eof = true
+			} else {
+				return nil, err
+			}
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if _, err := transitionSystem.TransitionFromString(line, labelNumberer); err != nil {
+			return nil, &TransitionSyntaxError{
+				Line:     lineNum,
+				Context:  line,
+				Msg:      fmt.Sprintf("invalid transition: %s", err),
+				InnerErr: err,
+			}
+		}
+	}
+
+	return labelNumberer, nil
+}
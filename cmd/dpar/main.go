@@ -0,0 +1,11 @@
+// Copyright 2015 The dpar Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command dpar bundles the parser's training, evaluation and parsing
+// pipelines into a single binary.
+package main
+
+func main() {
+	Execute()
+}
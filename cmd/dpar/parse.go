@@ -6,8 +6,6 @@ package main
 
 import (
 	"bufio"
-	"flag"
-	"fmt"
 	"hash/fnv"
 	"log"
 	"os"
@@ -18,28 +16,24 @@ import (
 	"github.com/danieldk/dpar/features/symbolic"
 	"github.com/danieldk/dpar/ml/svm"
 	"github.com/danieldk/dpar/system"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"gopkg.in/danieldk/golinear.v1"
 )
 
-func init() {
-	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: %s [options] config input.conllx\n\n", os.Args[0])
-		flag.PrintDefaults()
-	}
+var parseCmd = &cobra.Command{
+	Use:   "parse INPUT.conllx",
+	Short: "Parse a CoNLL-X file with a trained model",
+	Args:  cobra.ExactArgs(1),
+	Run:   runParse,
 }
 
-func main() {
-	flag.Parse()
-
-	if flag.NArg() != 2 {
-		flag.Usage()
-		os.Exit(1)
-	}
+func init() {
+	rootCmd.AddCommand(parseCmd)
+}
 
-	configFile, err := os.Open(flag.Arg(0))
-	common.ExitIfError(err)
-	defer configFile.Close()
-	config, err := common.ParseConfig(configFile)
+func runParse(cmd *cobra.Command, args []string) {
+	config, err := common.ConfigFromViper(viper.GetViper())
 	common.ExitIfError(err)
 
 	generator, err := common.ReadFeatures(config.Parser.Features)
@@ -57,24 +51,24 @@ func main() {
 	common.ExitIfError(err)
 
 	hashKernelParsing(transitionSystem, generator, model, labelNumberer,
-		config.Parser.HashKernelSize)
+		config.Parser.HashKernelSize, args[0])
 }
 
 func hashKernelParsing(transitionSystem system.TransitionSystem,
 	generator symbolic.FeatureGenerator, model *golinear.Model,
-	labelNumberer *system.LabelNumberer, hashKernelSize uint) {
+	labelNumberer *system.LabelNumberer, hashKernelSize uint, inputPath string) {
 	guide := svm.NewHashingSVMGuide(model, generator, *labelNumberer, fnv.New32,
 		hashKernelSize)
 	parser := system.NewGreedyParser(transitionSystem, guide)
 
 	start := time.Now()
-	run(parser)
+	parseFile(parser, inputPath)
 	elapsed := time.Since(start)
 	log.Printf("Parsing took %s\n", elapsed)
 }
 
-func run(parser system.Parser) {
-	inputFile, err := os.Open(flag.Arg(1))
+func parseFile(parser system.Parser, inputPath string) {
+	inputFile, err := os.Open(inputPath)
 	defer inputFile.Close()
 	if err != nil {
 		panic("Cannot open training data")
@@ -106,4 +100,4 @@ func run(parser system.Parser) {
 
 		writer.WriteSentence(s)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,82 @@
+// Copyright 2015 The dpar Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var cfgFile string
+
+var rootCmd = &cobra.Command{
+	Use:   "dpar",
+	Short: "dpar trains, evaluates and runs transition-based dependency parsers",
+	Long: `dpar trains, evaluates and runs transition-based dependency parsers.
+
+Every parser.* configuration key (parser.model, parser.features,
+parser.transitions, parser.hashKernelSize, parser.system) can be set
+through the config file, through an environment variable prefixed
+with DPAR_ (e.g. DPAR_PARSER_MODEL), or through the matching flag.
+When a setting is given in more than one place, flags win over
+environment variables, which in turn win over the config file.`,
+}
+
+func init() {
+	cobra.OnInitialize(initConfig)
+
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "configuration file")
+	rootCmd.PersistentFlags().String("log-level", "info", "logging verbosity (debug, info, warn, error)")
+	rootCmd.PersistentFlags().String("model", "", "path of the trained model")
+	rootCmd.PersistentFlags().String("features", "", "path of the feature template file")
+	rootCmd.PersistentFlags().String("transitions", "", "path of the transitions file")
+	rootCmd.PersistentFlags().Uint("hash-kernel-size", 0, "size of the feature hashing kernel, 0 disables hashing")
+	rootCmd.PersistentFlags().String("transition-system", "", "transition system to use (e.g. archybrid, arceager)")
+
+	viper.BindPFlag("logLevel", rootCmd.PersistentFlags().Lookup("log-level"))
+	viper.BindPFlag("parser.model", rootCmd.PersistentFlags().Lookup("model"))
+	viper.BindPFlag("parser.features", rootCmd.PersistentFlags().Lookup("features"))
+	viper.BindPFlag("parser.transitions", rootCmd.PersistentFlags().Lookup("transitions"))
+	viper.BindPFlag("parser.hashKernelSize", rootCmd.PersistentFlags().Lookup("hash-kernel-size"))
+	viper.BindPFlag("parser.system", rootCmd.PersistentFlags().Lookup("transition-system"))
+
+	// BindPFlag alone only wires up the flag and the config file;
+	// Unmarshal (unlike Get) ignores AutomaticEnv for keys it
+	// doesn't already know about, so each parser.* key needs an
+	// explicit BindEnv for DPAR_PARSER_* overrides to actually reach
+	// the Config struct.
+	viper.BindEnv("parser.model", "DPAR_PARSER_MODEL")
+	viper.BindEnv("parser.features", "DPAR_PARSER_FEATURES")
+	viper.BindEnv("parser.transitions", "DPAR_PARSER_TRANSITIONS")
+	viper.BindEnv("parser.hashKernelSize", "DPAR_PARSER_HASHKERNELSIZE")
+	viper.BindEnv("parser.system", "DPAR_PARSER_SYSTEM")
+}
+
+func initConfig() {
+	viper.SetEnvPrefix("dpar")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.AutomaticEnv()
+
+	if cfgFile == "" {
+		return
+	}
+
+	viper.SetConfigFile(cfgFile)
+	if err := viper.ReadInConfig(); err != nil {
+		log.Fatalf("Cannot read configuration file: %s", err)
+	}
+}
+
+// Execute runs the dpar command tree, exiting the process with a
+// non-zero status if a subcommand fails.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
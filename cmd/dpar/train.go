@@ -0,0 +1,23 @@
+// Copyright 2015 The dpar Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+)
+
+var trainCmd = &cobra.Command{
+	Use:   "train",
+	Short: "Train a parser model from a CoNLL-X treebank",
+	Run: func(cmd *cobra.Command, args []string) {
+		log.Fatal("dpar train: not yet ported to the unified binary")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(trainCmd)
+}
@@ -0,0 +1,31 @@
+package symbolic
+
+import "fmt"
+
+// FeatureSyntaxError is returned when a feature description file
+// cannot be parsed. It carries enough context to let the caller
+// point a user directly at the offending line.
+type FeatureSyntaxError struct {
+	// Line is the 1-based line number at which parsing failed.
+	Line uint
+
+	// Context is the (trimmed) text of the offending line.
+	Context string
+
+	// Msg describes what went wrong.
+	Msg string
+
+	// InnerErr is the underlying error, if any, that caused the
+	// failure (e.g. an error from addr.ParseAddressedValueTemplates).
+	InnerErr error
+}
+
+func (e *FeatureSyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at line %d: %s\n\t%d\t%s", e.Line, e.Msg, e.Line, e.Context)
+}
+
+// Unwrap returns the error that caused this syntax error, allowing
+// callers to use errors.As/errors.Is to inspect it.
+func (e *FeatureSyntaxError) Unwrap() error {
+	return e.InnerErr
+}
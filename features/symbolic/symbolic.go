@@ -2,7 +2,6 @@ package symbolic
 
 import (
 	"bufio"
-	"errors"
 	"fmt"
 	"hash"
 	"io"
@@ -82,11 +81,13 @@ func ReadFeatureGeneratorsDefault(reader *bufio.Reader) (FeatureGenerator, error
 func ReadFeatureGenerators(fs FeatureGeneratorFactories,
 	reader *bufio.Reader) (FeatureGenerator, error) {
 	var eof = false
+	var lineNum uint
 
 	var generators []FeatureGenerator
 
 	for !eof {
 		line, err := reader.ReadString('\n')
+		lineNum++
 
 		if err != nil {
 			if err == io.EOF {
@@ -102,7 +103,7 @@ func ReadFeatureGenerators(fs FeatureGeneratorFactories,
 			continue
 		}
 
-		g, err := parseGenerator(fs, line)
+		g, err := parseGenerator(fs, line, lineNum)
 		if err != nil {
 			return nil, err
 		}
@@ -113,19 +114,37 @@ func ReadFeatureGenerators(fs FeatureGeneratorFactories,
 	return AggregateGenerator{generators}, nil
 }
 
-func parseGenerator(fs FeatureGeneratorFactories, line string) (FeatureGenerator, error) {
+func parseGenerator(fs FeatureGeneratorFactories, line string, lineNum uint) (FeatureGenerator, error) {
 	sepIdx := strings.IndexByte(line, ' ')
 	if sepIdx == -1 {
-		return nil, errors.New("Line should at the very least specify a generator.")
+		return nil, &FeatureSyntaxError{
+			Line:    lineNum,
+			Context: line,
+			Msg:     "line should at the very least specify a generator",
+		}
 	}
 
 	generatorName := line[:sepIdx]
 	factory, ok := fs[generatorName]
 	if !ok {
-		return nil, fmt.Errorf("Unknown generator: %s", generatorName)
+		return nil, &FeatureSyntaxError{
+			Line:    lineNum,
+			Context: line,
+			Msg:     fmt.Sprintf("unknown generator: %s", generatorName),
+		}
+	}
+
+	g, err := factory([]byte(line[sepIdx+1:]))
+	if err != nil {
+		return nil, &FeatureSyntaxError{
+			Line:     lineNum,
+			Context:  line,
+			Msg:      fmt.Sprintf("invalid arguments for generator %s: %s", generatorName, err),
+			InnerErr: err,
+		}
 	}
 
-	return factory([]byte(line[sepIdx+1:]))
+	return g, nil
 }
 
 func parseAddressedValueGenerator(data []byte) (FeatureGenerator, error) {